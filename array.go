@@ -0,0 +1,186 @@
+// Copyright (c) 2016 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nanozap
+
+import (
+	"time"
+
+	"github.com/templexxx/nanozap/zapcore"
+)
+
+// bools implements zapcore.ArrayMarshaler for a []bool without boxing each
+// element through interface{}.
+type bools []bool
+
+func (bs bools) MarshalLogArray(arr zapcore.ArrayEncoder) error {
+	for i := range bs {
+		arr.AppendBool(bs[i])
+	}
+	return nil
+}
+
+type ints []int
+
+func (is ints) MarshalLogArray(arr zapcore.ArrayEncoder) error {
+	for i := range is {
+		arr.AppendInt(is[i])
+	}
+	return nil
+}
+
+type int64s []int64
+
+func (is int64s) MarshalLogArray(arr zapcore.ArrayEncoder) error {
+	for i := range is {
+		arr.AppendInt64(is[i])
+	}
+	return nil
+}
+
+type uints []uint
+
+func (us uints) MarshalLogArray(arr zapcore.ArrayEncoder) error {
+	for i := range us {
+		arr.AppendUint(us[i])
+	}
+	return nil
+}
+
+type float64s []float64
+
+func (fs float64s) MarshalLogArray(arr zapcore.ArrayEncoder) error {
+	for i := range fs {
+		arr.AppendFloat64(fs[i])
+	}
+	return nil
+}
+
+type durations []time.Duration
+
+func (ds durations) MarshalLogArray(arr zapcore.ArrayEncoder) error {
+	for i := range ds {
+		arr.AppendDuration(ds[i])
+	}
+	return nil
+}
+
+type times []time.Time
+
+func (ts times) MarshalLogArray(arr zapcore.ArrayEncoder) error {
+	for i := range ts {
+		arr.AppendTime(ts[i])
+	}
+	return nil
+}
+
+type stringArray []string
+
+func (ss stringArray) MarshalLogArray(arr zapcore.ArrayEncoder) error {
+	for i := range ss {
+		arr.AppendString(ss[i])
+	}
+	return nil
+}
+
+type byteStringsArray [][]byte
+
+func (bs byteStringsArray) MarshalLogArray(arr zapcore.ArrayEncoder) error {
+	for i := range bs {
+		arr.AppendByteString(bs[i])
+	}
+	return nil
+}
+
+// errorArray renders each non-nil error via zapcore.RenderError, the same
+// panic-safe logic NamedError uses for a single error field: it prefers
+// MarshalLogObject when available and otherwise falls back to a
+// recovered Error() string.
+type errorArray []error
+
+func (errs errorArray) MarshalLogArray(arr zapcore.ArrayEncoder) error {
+	for i := range errs {
+		if errs[i] == nil {
+			continue
+		}
+		m, s, ok := zapcore.RenderError(errs[i])
+		if ok {
+			if err := arr.AppendObject(m); err == nil {
+				continue
+			}
+		}
+		arr.AppendString(s)
+	}
+	return nil
+}
+
+// Array constructs a field with the given key and ArrayMarshaler. It
+// provides a flexible, but still type-safe and efficient, way to add
+// slice-like user-defined types to the logging context. The struct's
+// MarshalLogArray method is called lazily.
+func Array(key string, val zapcore.ArrayMarshaler) Field {
+	return Field{Key: key, Type: zapcore.ArrayMarshalerType, Interface: val}
+}
+
+// Bools constructs a field that carries a slice of bools.
+func Bools(key string, bs []bool) Field {
+	return Array(key, bools(bs))
+}
+
+// Ints constructs a field that carries a slice of ints.
+func Ints(key string, is []int) Field {
+	return Array(key, ints(is))
+}
+
+// Int64s constructs a field that carries a slice of int64s.
+func Int64s(key string, is []int64) Field {
+	return Array(key, int64s(is))
+}
+
+// Uints constructs a field that carries a slice of uints.
+func Uints(key string, us []uint) Field {
+	return Array(key, uints(us))
+}
+
+// Float64s constructs a field that carries a slice of float64s.
+func Float64s(key string, fs []float64) Field {
+	return Array(key, float64s(fs))
+}
+
+// Durations constructs a field that carries a slice of time.Durations.
+func Durations(key string, ds []time.Duration) Field {
+	return Array(key, durations(ds))
+}
+
+// Times constructs a field that carries a slice of time.Times.
+func Times(key string, ts []time.Time) Field {
+	return Array(key, times(ts))
+}
+
+// Strings constructs a field that carries a slice of strings.
+func Strings(key string, ss []string) Field {
+	return Array(key, stringArray(ss))
+}
+
+// ByteStrings constructs a field that carries a slice of []byte, each
+// interpreted as UTF-8 encoded text.
+func ByteStrings(key string, bs [][]byte) Field {
+	return Array(key, byteStringsArray(bs))
+}
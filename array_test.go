@@ -0,0 +1,71 @@
+// Copyright (c) 2016 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nanozap
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/templexxx/nanozap/zapcore"
+)
+
+func TestArrayFieldsRoundTrip(t *testing.T) {
+	enc := zapcore.NewJSONEncoder()
+	Bools("bs", []bool{true, false}).AddTo(enc)
+	Ints("is", []int{1, 2, 3}).AddTo(enc)
+	Int64s("i64s", []int64{4, 5}).AddTo(enc)
+	Uints("us", []uint{6, 7}).AddTo(enc)
+	Float64s("fs", []float64{1.5, 2.5}).AddTo(enc)
+	Durations("ds", []time.Duration{time.Second}).AddTo(enc)
+	Strings("ss", []string{"a", "b"}).AddTo(enc)
+	ByteStrings("bss", [][]byte{[]byte("c")}).AddTo(enc)
+
+	got := enc.Buffer().Bytes()
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(got, &decoded); err != nil {
+		t.Fatalf("output %q is not valid JSON: %v", got, err)
+	}
+
+	bs, ok := decoded["bs"].([]interface{})
+	if !ok || len(bs) != 2 || bs[0] != true || bs[1] != false {
+		t.Errorf("bs = %v, want [true false]", decoded["bs"])
+	}
+	is, ok := decoded["is"].([]interface{})
+	if !ok || len(is) != 3 {
+		t.Errorf("is = %v, want 3 elements", decoded["is"])
+	}
+	ss, ok := decoded["ss"].([]interface{})
+	if !ok || len(ss) != 2 || ss[0] != "a" || ss[1] != "b" {
+		t.Errorf("ss = %v, want [a b]", decoded["ss"])
+	}
+}
+
+func TestArrayFieldEmptySlice(t *testing.T) {
+	enc := zapcore.NewJSONEncoder()
+	Ints("is", nil).AddTo(enc)
+
+	got := enc.Buffer().String()
+	const want = `{"is":[]}`
+	if got != want {
+		t.Fatalf("Buffer() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2016 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nanozap
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/templexxx/nanozap/zapcore"
+)
+
+type typedNilError struct {
+	reason string
+}
+
+func (e *typedNilError) Error() string {
+	// Simulates the classic typed-nil footgun: calling a method through a
+	// non-nil error interface whose underlying pointer is nil panics the
+	// moment it dereferences a field.
+	return e.reason
+}
+
+func TestNamedErrorSkipsNil(t *testing.T) {
+	f := NamedError("err", nil)
+	if f.Type != zapcore.SkipType {
+		t.Fatalf("NamedError(nil) field type = %v, want SkipType", f.Type)
+	}
+}
+
+func TestErrorAndErrorsRoundTrip(t *testing.T) {
+	enc := zapcore.NewJSONEncoder()
+	Error(errors.New("boom")).AddTo(enc)
+	Errors("errs", []error{errors.New("a"), nil, errors.New("b")}).AddTo(enc)
+
+	got := enc.Buffer().Bytes()
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(got, &decoded); err != nil {
+		t.Fatalf("output %q is not valid JSON: %v", got, err)
+	}
+	if decoded["error"] != "boom" {
+		t.Errorf("error = %v, want %q", decoded["error"], "boom")
+	}
+	errs, ok := decoded["errs"].([]interface{})
+	if !ok || len(errs) != 2 || errs[0] != "a" || errs[1] != "b" {
+		t.Errorf("errs = %v, want [a b] (nil skipped)", decoded["errs"])
+	}
+}
+
+// TestErrorsTypedNilDoesNotPanic exercises the exact failure mode the
+// request called out: an error whose Error() method panics on a nil
+// receiver must not take down the log call, whether encoded singly via
+// NamedError or as part of an Errors slice.
+func TestErrorsTypedNilDoesNotPanic(t *testing.T) {
+	var bad *typedNilError
+
+	enc := zapcore.NewJSONEncoder()
+	NamedError("single", bad).AddTo(enc)
+	Errors("many", []error{bad}).AddTo(enc)
+
+	got := enc.Buffer().Bytes()
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(got, &decoded); err != nil {
+		t.Fatalf("output %q is not valid JSON: %v", got, err)
+	}
+}
+
+func TestTimeClampsToRange(t *testing.T) {
+	far := time.Date(294247, time.January, 1, 0, 0, 0, 0, time.UTC)
+	f := Time("t", far)
+	if f.Integer != time.Unix(0, math.MaxInt64).UnixNano() {
+		t.Errorf("Integer = %d, want clamped max", f.Integer)
+	}
+}
@@ -179,10 +179,22 @@ func Stringer(key string, val fmt.Stringer) Field {
 
 // Time constructs a Field with the given key and value. The encoder
 // controls how the time is serialized.
-func Time(key string, val int64) Field {
-	return Field{Key: key, Type: zapcore.TimeType, Integer: val}
+func Time(key string, val time.Time) Field {
+	if val.Before(minTime) {
+		val = minTime
+	} else if val.After(maxTime) {
+		val = maxTime
+	}
+	return Field{Key: key, Type: zapcore.TimeType, Integer: val.UnixNano(), Interface: val.Location()}
 }
 
+// minTime and maxTime bound the range of time.Time values Time can encode
+// as a UnixNano int64 without overflowing.
+var (
+	minTime = time.Unix(0, math.MinInt64)
+	maxTime = time.Unix(0, math.MaxInt64)
+)
+
 // Duration constructs a field with the given key and value. The encoder
 // controls how the duration is serialized.
 func Duration(key string, val time.Duration) Field {
@@ -195,4 +207,197 @@ func Duration(key string, val time.Duration) Field {
 // MarshalLogObject method is called lazily.
 func Object(key string, val zapcore.ObjectMarshaler) Field {
 	return Field{Key: key, Type: zapcore.ObjectMarshalerType, Interface: val}
-}
\ No newline at end of file
+}
+
+// Error is shorthand for the common case of adding an error under the key
+// "error".
+func Error(err error) Field {
+	return NamedError("error", err)
+}
+
+// NamedError constructs a field that carries an error under the given key.
+// A nil error is a no-op. The error's MarshalLogObject method is used if it
+// implements ObjectMarshaler; otherwise the field is rendered as the
+// result of calling Error().
+func NamedError(key string, err error) Field {
+	if err == nil {
+		return Skip()
+	}
+	return Field{Key: key, Type: zapcore.ErrorType, Interface: err}
+}
+
+// Errors constructs a field that carries a slice of errors. Nil errors are
+// skipped; each remaining error is rendered the same way NamedError renders
+// a single error.
+func Errors(key string, errs []error) Field {
+	return Array(key, errorArray(errs))
+}
+
+// nilField constructs a field that marshals as an explicit JSON null. It
+// backs the pointer-typed constructors below, so a nil pointer is rendered
+// without paying for the Reflect allocation path.
+func nilField(key string) Field {
+	return Field{Key: key, Type: zapcore.NilType}
+}
+
+// Boolp constructs a field that carries a *bool. The returned Field will
+// safely and explicitly represent `nil` when the pointer is nil.
+func Boolp(key string, val *bool) Field {
+	if val == nil {
+		return nilField(key)
+	}
+	return Bool(key, *val)
+}
+
+// Int8p constructs a field that carries a *int8. The returned Field will
+// safely and explicitly represent `nil` when the pointer is nil.
+func Int8p(key string, val *int8) Field {
+	if val == nil {
+		return nilField(key)
+	}
+	return Int8(key, *val)
+}
+
+// Int16p constructs a field that carries a *int16. The returned Field will
+// safely and explicitly represent `nil` when the pointer is nil.
+func Int16p(key string, val *int16) Field {
+	if val == nil {
+		return nilField(key)
+	}
+	return Int16(key, *val)
+}
+
+// Int32p constructs a field that carries a *int32. The returned Field will
+// safely and explicitly represent `nil` when the pointer is nil.
+func Int32p(key string, val *int32) Field {
+	if val == nil {
+		return nilField(key)
+	}
+	return Int32(key, *val)
+}
+
+// Int64p constructs a field that carries a *int64. The returned Field will
+// safely and explicitly represent `nil` when the pointer is nil.
+func Int64p(key string, val *int64) Field {
+	if val == nil {
+		return nilField(key)
+	}
+	return Int64(key, *val)
+}
+
+// Intp constructs a field that carries a *int. The returned Field will
+// safely and explicitly represent `nil` when the pointer is nil.
+func Intp(key string, val *int) Field {
+	if val == nil {
+		return nilField(key)
+	}
+	return Int(key, *val)
+}
+
+// Uint8p constructs a field that carries a *uint8. The returned Field will
+// safely and explicitly represent `nil` when the pointer is nil.
+func Uint8p(key string, val *uint8) Field {
+	if val == nil {
+		return nilField(key)
+	}
+	return Uint8(key, *val)
+}
+
+// Uint16p constructs a field that carries a *uint16. The returned Field will
+// safely and explicitly represent `nil` when the pointer is nil.
+func Uint16p(key string, val *uint16) Field {
+	if val == nil {
+		return nilField(key)
+	}
+	return Uint16(key, *val)
+}
+
+// Uint32p constructs a field that carries a *uint32. The returned Field will
+// safely and explicitly represent `nil` when the pointer is nil.
+func Uint32p(key string, val *uint32) Field {
+	if val == nil {
+		return nilField(key)
+	}
+	return Uint32(key, *val)
+}
+
+// Uint64p constructs a field that carries a *uint64. The returned Field will
+// safely and explicitly represent `nil` when the pointer is nil.
+func Uint64p(key string, val *uint64) Field {
+	if val == nil {
+		return nilField(key)
+	}
+	return Uint64(key, *val)
+}
+
+// Uintp constructs a field that carries a *uint. The returned Field will
+// safely and explicitly represent `nil` when the pointer is nil.
+func Uintp(key string, val *uint) Field {
+	if val == nil {
+		return nilField(key)
+	}
+	return Uint(key, *val)
+}
+
+// Float32p constructs a field that carries a *float32. The returned Field
+// will safely and explicitly represent `nil` when the pointer is nil.
+func Float32p(key string, val *float32) Field {
+	if val == nil {
+		return nilField(key)
+	}
+	return Float32(key, *val)
+}
+
+// Float64p constructs a field that carries a *float64. The returned Field
+// will safely and explicitly represent `nil` when the pointer is nil.
+func Float64p(key string, val *float64) Field {
+	if val == nil {
+		return nilField(key)
+	}
+	return Float64(key, *val)
+}
+
+// Complex64p constructs a field that carries a *complex64. The returned
+// Field will safely and explicitly represent `nil` when the pointer is nil.
+func Complex64p(key string, val *complex64) Field {
+	if val == nil {
+		return nilField(key)
+	}
+	return Complex64(key, *val)
+}
+
+// Complex128p constructs a field that carries a *complex128. The returned
+// Field will safely and explicitly represent `nil` when the pointer is nil.
+func Complex128p(key string, val *complex128) Field {
+	if val == nil {
+		return nilField(key)
+	}
+	return Complex128(key, *val)
+}
+
+// Stringp constructs a field that carries a *string. The returned Field
+// will safely and explicitly represent `nil` when the pointer is nil.
+func Stringp(key string, val *string) Field {
+	if val == nil {
+		return nilField(key)
+	}
+	return String(key, *val)
+}
+
+// Durationp constructs a field that carries a *time.Duration. The returned
+// Field will safely and explicitly represent `nil` when the pointer is nil.
+func Durationp(key string, val *time.Duration) Field {
+	if val == nil {
+		return nilField(key)
+	}
+	return Duration(key, *val)
+}
+
+// Timep constructs a field that carries a *time.Time. The returned Field
+// will safely and explicitly represent `nil` when the pointer is nil.
+func Timep(key string, val *time.Time) Field {
+	if val == nil {
+		return nilField(key)
+	}
+	return Time(key, *val)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2016 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nanozap
+
+import (
+	"testing"
+
+	"github.com/templexxx/nanozap/zapcore"
+)
+
+func TestPointerFieldsNilRendersNull(t *testing.T) {
+	enc := zapcore.NewJSONEncoder()
+	Boolp("b", nil).AddTo(enc)
+	Stringp("s", nil).AddTo(enc)
+	Int64p("i", nil).AddTo(enc)
+
+	got := enc.Buffer().String()
+	const want = `{"b":null,"s":null,"i":null}`
+	if got != want {
+		t.Fatalf("Buffer() = %q, want %q", got, want)
+	}
+}
+
+func TestPointerFieldsNonNilDelegates(t *testing.T) {
+	b := true
+	enc := zapcore.NewJSONEncoder()
+	Boolp("b", &b).AddTo(enc)
+
+	got := enc.Buffer().String()
+	const want = `{"b":true}`
+	if got != want {
+		t.Fatalf("Buffer() = %q, want %q", got, want)
+	}
+}
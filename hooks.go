@@ -0,0 +1,69 @@
+// Copyright (c) 2016 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nanozap
+
+import "github.com/templexxx/nanozap/zapcore"
+
+// Hooks returns a function that wraps a Core so that every successfully
+// written Entry triggers each of hooks, in order. It's useful for counting
+// entries per level or otherwise observing log traffic without building a
+// second Core:
+//
+//	core = nanozap.Hooks(countEntriesByLevel)(core)
+func Hooks(hooks ...func(zapcore.Entry) error) func(zapcore.Core) zapcore.Core {
+	funcs := append([]func(zapcore.Entry) error{}, hooks...)
+	return func(core zapcore.Core) zapcore.Core {
+		return &hookedCore{Core: core, hooks: funcs}
+	}
+}
+
+type hookedCore struct {
+	zapcore.Core
+	hooks []func(zapcore.Entry) error
+}
+
+func (h *hookedCore) With(fields []zapcore.Field) zapcore.Core {
+	return &hookedCore{Core: h.Core.With(fields), hooks: h.hooks}
+}
+
+// Check defers to the wrapped Core's own Check (so a sampler or other
+// filtering Core underneath still gets a say), but registers h itself with
+// the CheckedEntry rather than h.Core, so Write below runs for this entry
+// and the hooks fire.
+func (h *hookedCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if h.Core.Check(ent, nil) == nil {
+		return ce
+	}
+	return ce.AddCore(ent, h)
+}
+
+func (h *hookedCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	if err := h.Core.Write(ent, fields); err != nil {
+		return err
+	}
+	var err error
+	for _, hook := range h.hooks {
+		if hookErr := hook(ent); hookErr != nil && err == nil {
+			err = hookErr
+		}
+	}
+	return err
+}
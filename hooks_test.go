@@ -0,0 +1,90 @@
+// Copyright (c) 2016 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nanozap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/templexxx/nanozap/zapcore"
+)
+
+// stubCore is a minimal zapcore.Core whose Check decision is fixed by
+// agrees, so tests can simulate a wrapped Core (e.g. a sampler) that
+// either accepts or drops every entry.
+type stubCore struct {
+	agrees  bool
+	written int
+}
+
+func (c *stubCore) Enabled(zapcore.Level) bool        { return true }
+func (c *stubCore) With([]zapcore.Field) zapcore.Core { return c }
+func (c *stubCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.agrees {
+		return ce
+	}
+	return ce.AddCore(ent, c)
+}
+func (c *stubCore) Write(zapcore.Entry, []zapcore.Field) error {
+	c.written++
+	return nil
+}
+func (c *stubCore) Sync() error { return nil }
+
+func TestHooksFireOncePerWrittenEntry(t *testing.T) {
+	inner := &stubCore{agrees: true}
+	fired := 0
+	core := Hooks(func(zapcore.Entry) error {
+		fired++
+		return nil
+	})(inner)
+
+	ent := zapcore.Entry{Level: zapcore.InfoLevel, Message: "hi", Time: time.Unix(0, 0)}
+	ce := core.Check(ent, nil)
+	ce.Write()
+
+	if fired != 1 {
+		t.Errorf("hook fired %d times, want 1", fired)
+	}
+	if inner.written != 1 {
+		t.Errorf("wrapped Core Write called %d times, want 1", inner.written)
+	}
+}
+
+func TestHooksDoNotFireWhenCoreDrops(t *testing.T) {
+	inner := &stubCore{agrees: false}
+	fired := 0
+	core := Hooks(func(zapcore.Entry) error {
+		fired++
+		return nil
+	})(inner)
+
+	ent := zapcore.Entry{Level: zapcore.InfoLevel, Message: "hi", Time: time.Unix(0, 0)}
+	ce := core.Check(ent, nil)
+	ce.Write()
+
+	if fired != 0 {
+		t.Errorf("hook fired %d times, want 0 (wrapped Core dropped the entry)", fired)
+	}
+	if inner.written != 0 {
+		t.Errorf("wrapped Core Write called %d times, want 0", inner.written)
+	}
+}
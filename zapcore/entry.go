@@ -0,0 +1,68 @@
+// Copyright (c) 2016 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package zapcore
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// Entry represents a complete log entry, without any structured fields.
+type Entry struct {
+	Level      Level
+	Time       time.Time
+	LoggerName string
+	Message    string
+}
+
+// CheckedEntry is an Entry together with the Cores that have agreed to log
+// it. A Logger builds one via Core.Check, then calls Write once fields are
+// available.
+type CheckedEntry struct {
+	Entry
+	cores []Core
+}
+
+// AddCore adds a Core that has agreed to log this entry. It allocates the
+// CheckedEntry if ce is nil, so callers chain it as
+// `ce = ce.AddCore(ent, core)`.
+func (ce *CheckedEntry) AddCore(ent Entry, core Core) *CheckedEntry {
+	if ce == nil {
+		ce = &CheckedEntry{Entry: ent}
+	}
+	ce.cores = append(ce.cores, core)
+	return ce
+}
+
+// Write writes the entry to every Core that agreed to log it. A Core's
+// write error doesn't stop the others from running, but is reported to
+// stderr so it isn't lost silently.
+func (ce *CheckedEntry) Write(fields ...Field) {
+	if ce == nil {
+		return
+	}
+	for _, core := range ce.cores {
+		if err := core.Write(ce.Entry, fields); err != nil {
+			fmt.Fprintf(os.Stderr, "zapcore: could not write entry: %v\n", err)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2016 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package zapcore
+
+import "fmt"
+
+// encodeError adds err under key, preferring its MarshalLogObject
+// implementation (if any) over its Error() string.
+func encodeError(enc ObjectEncoder, key string, err error) {
+	m, s, ok := RenderError(err)
+	if ok {
+		if addErr := enc.AddObject(key, m); addErr == nil {
+			return
+		}
+	}
+	enc.AddString(key, s)
+}
+
+// RenderError reports how err should be rendered: if it implements
+// ObjectMarshaler, m is that implementation and ok is true; s is always
+// the panic-safe result of calling err.Error(), for use as a fallback (or
+// as the only rendering, when ok is false). Exported so callers outside
+// zapcore — e.g. the top-level Errors array field — can apply the exact
+// same error-rendering rules as a single NamedError field.
+func RenderError(err error) (m ObjectMarshaler, s string, ok bool) {
+	s = verifyField(err)
+	m, ok = err.(ObjectMarshaler)
+	return m, s, ok
+}
+
+// verifyField calls err.Error(), recovering from a panic so that a
+// misbehaving error implementation (e.g. a typed-nil receiver) can't bring
+// down the whole log call.
+func verifyField(err error) (s string) {
+	defer func() {
+		if r := recover(); r != nil {
+			s = fmt.Sprintf("PANIC=%v", r)
+		}
+	}()
+	return err.Error()
+}
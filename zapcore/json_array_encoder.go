@@ -0,0 +1,147 @@
+// Copyright (c) 2016 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package zapcore
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// jsonArrayEncoder is the ArrayEncoder counterpart of jsonEncoder: it
+// collects elements (rather than key-value pairs) into a JSON array.
+type jsonArrayEncoder struct {
+	buf       *bytes.Buffer
+	firstElem bool
+}
+
+func newJSONArrayEncoder() *jsonArrayEncoder {
+	return &jsonArrayEncoder{buf: &bytes.Buffer{}, firstElem: true}
+}
+
+func (enc *jsonArrayEncoder) nextElem() {
+	if !enc.firstElem {
+		enc.buf.WriteByte(',')
+	}
+	enc.firstElem = false
+}
+
+func (enc *jsonArrayEncoder) AppendBool(val bool) {
+	enc.nextElem()
+	enc.buf.WriteString(strconv.FormatBool(val))
+}
+
+func (enc *jsonArrayEncoder) AppendByteString(val []byte) {
+	enc.nextElem()
+	b, _ := json.Marshal(string(val))
+	enc.buf.Write(b)
+}
+
+func (enc *jsonArrayEncoder) AppendComplex128(val complex128) {
+	enc.nextElem()
+	b, _ := json.Marshal(strconv.FormatComplex(val, 'g', -1, 128))
+	enc.buf.Write(b)
+}
+
+func (enc *jsonArrayEncoder) AppendComplex64(val complex64) {
+	enc.AppendComplex128(complex128(val))
+}
+
+func (enc *jsonArrayEncoder) AppendDuration(val time.Duration) {
+	enc.nextElem()
+	b, _ := json.Marshal(val.String())
+	enc.buf.Write(b)
+}
+
+func (enc *jsonArrayEncoder) AppendFloat64(val float64) {
+	enc.nextElem()
+	enc.buf.WriteString(strconv.FormatFloat(val, 'f', -1, 64))
+}
+
+func (enc *jsonArrayEncoder) AppendFloat32(val float32) {
+	enc.AppendFloat64(float64(val))
+}
+
+func (enc *jsonArrayEncoder) AppendInt(val int) { enc.AppendInt64(int64(val)) }
+func (enc *jsonArrayEncoder) AppendInt64(val int64) {
+	enc.nextElem()
+	enc.buf.WriteString(strconv.FormatInt(val, 10))
+}
+func (enc *jsonArrayEncoder) AppendInt32(val int32) { enc.AppendInt64(int64(val)) }
+func (enc *jsonArrayEncoder) AppendInt16(val int16) { enc.AppendInt64(int64(val)) }
+func (enc *jsonArrayEncoder) AppendInt8(val int8)   { enc.AppendInt64(int64(val)) }
+
+func (enc *jsonArrayEncoder) AppendString(val string) {
+	enc.nextElem()
+	b, _ := json.Marshal(val)
+	enc.buf.Write(b)
+}
+
+func (enc *jsonArrayEncoder) AppendTime(val time.Time) {
+	enc.AppendString(val.Format(time.RFC3339Nano))
+}
+
+func (enc *jsonArrayEncoder) AppendUint(val uint) { enc.AppendUint64(uint64(val)) }
+func (enc *jsonArrayEncoder) AppendUint64(val uint64) {
+	enc.nextElem()
+	enc.buf.WriteString(strconv.FormatUint(val, 10))
+}
+func (enc *jsonArrayEncoder) AppendUint32(val uint32)   { enc.AppendUint64(uint64(val)) }
+func (enc *jsonArrayEncoder) AppendUint16(val uint16)   { enc.AppendUint64(uint64(val)) }
+func (enc *jsonArrayEncoder) AppendUint8(val uint8)     { enc.AppendUint64(uint64(val)) }
+func (enc *jsonArrayEncoder) AppendUintptr(val uintptr) { enc.AppendUint64(uint64(val)) }
+
+func (enc *jsonArrayEncoder) AppendReflected(val interface{}) error {
+	enc.nextElem()
+	if val == nil {
+		enc.buf.WriteString("null")
+		return nil
+	}
+	b, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	enc.buf.Write(b)
+	return nil
+}
+
+func (enc *jsonArrayEncoder) AppendArray(arr ArrayMarshaler) error {
+	inner := newJSONArrayEncoder()
+	if err := arr.MarshalLogArray(inner); err != nil {
+		return err
+	}
+	enc.nextElem()
+	enc.buf.WriteByte('[')
+	enc.buf.Write(inner.buf.Bytes())
+	enc.buf.WriteByte(']')
+	return nil
+}
+
+func (enc *jsonArrayEncoder) AppendObject(obj ObjectMarshaler) error {
+	inner := NewJSONEncoder()
+	if err := obj.MarshalLogObject(inner); err != nil {
+		return err
+	}
+	enc.nextElem()
+	enc.buf.Write(inner.Buffer().Bytes())
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2016 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package zapcore
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"strconv"
+	"time"
+)
+
+// jsonEncoder is a minimal ObjectEncoder that renders fields as a single
+// JSON object. It's the encoder the JSON-flavored loggers build on top of.
+type jsonEncoder struct {
+	buf *bytes.Buffer
+	// firstField tracks whether a comma is needed before the next key, so
+	// fields can be appended without buffering them first.
+	firstField bool
+	// openNamespaces counts the namespaces opened via OpenNamespace that
+	// haven't been closed yet. Buffer closes one '}' per open namespace,
+	// on top of the object's own closing brace.
+	openNamespaces int
+}
+
+// NewJSONEncoder returns a jsonEncoder, ready to have fields added to it.
+func NewJSONEncoder() *jsonEncoder {
+	return &jsonEncoder{buf: &bytes.Buffer{}, firstField: true}
+}
+
+// Buffer returns the accumulated JSON object, including its surrounding
+// braces and a closing brace for every namespace OpenNamespace opened.
+func (enc *jsonEncoder) Buffer() *bytes.Buffer {
+	out := &bytes.Buffer{}
+	out.WriteByte('{')
+	out.Write(enc.buf.Bytes())
+	for i := 0; i < enc.openNamespaces; i++ {
+		out.WriteByte('}')
+	}
+	out.WriteByte('}')
+	return out
+}
+
+func (enc *jsonEncoder) addKey(key string) {
+	if !enc.firstField {
+		enc.buf.WriteByte(',')
+	}
+	enc.firstField = false
+	enc.appendString(key)
+	enc.buf.WriteByte(':')
+}
+
+func (enc *jsonEncoder) appendString(s string) {
+	b, _ := json.Marshal(s)
+	enc.buf.Write(b)
+}
+
+// addNull writes a field value of JSON null directly, without going through
+// reflection. This is the fast path pointer-typed field constructors use
+// when the underlying pointer is nil.
+func (enc *jsonEncoder) addNull(key string) {
+	enc.addKey(key)
+	enc.buf.WriteString("null")
+}
+
+func (enc *jsonEncoder) AddBinary(key string, val []byte) {
+	enc.AddString(key, base64.StdEncoding.EncodeToString(val))
+}
+
+func (enc *jsonEncoder) AddByteString(key string, val []byte) {
+	enc.addKey(key)
+	enc.appendString(string(val))
+}
+
+func (enc *jsonEncoder) AddBool(key string, val bool) {
+	enc.addKey(key)
+	enc.buf.WriteString(strconv.FormatBool(val))
+}
+
+func (enc *jsonEncoder) AddComplex128(key string, val complex128) {
+	enc.AddString(key, strconv.FormatComplex(val, 'g', -1, 128))
+}
+
+func (enc *jsonEncoder) AddComplex64(key string, val complex64) {
+	enc.AddComplex128(key, complex128(val))
+}
+
+func (enc *jsonEncoder) AddDuration(key string, val time.Duration) {
+	enc.AddString(key, val.String())
+}
+
+func (enc *jsonEncoder) AddFloat64(key string, val float64) {
+	enc.addKey(key)
+	switch {
+	case math.IsNaN(val):
+		enc.appendString("NaN")
+	case math.IsInf(val, 1):
+		enc.appendString("+Inf")
+	case math.IsInf(val, -1):
+		enc.appendString("-Inf")
+	default:
+		enc.buf.WriteString(strconv.FormatFloat(val, 'f', -1, 64))
+	}
+}
+
+func (enc *jsonEncoder) AddFloat32(key string, val float32) {
+	enc.AddFloat64(key, float64(val))
+}
+
+func (enc *jsonEncoder) AddInt(key string, val int) { enc.AddInt64(key, int64(val)) }
+func (enc *jsonEncoder) AddInt64(key string, val int64) {
+	enc.addKey(key)
+	enc.buf.WriteString(strconv.FormatInt(val, 10))
+}
+func (enc *jsonEncoder) AddInt32(key string, val int32) { enc.AddInt64(key, int64(val)) }
+func (enc *jsonEncoder) AddInt16(key string, val int16) { enc.AddInt64(key, int64(val)) }
+func (enc *jsonEncoder) AddInt8(key string, val int8)   { enc.AddInt64(key, int64(val)) }
+
+func (enc *jsonEncoder) AddString(key, val string) {
+	enc.addKey(key)
+	enc.appendString(val)
+}
+
+func (enc *jsonEncoder) AddTime(key string, val time.Time) {
+	enc.AddString(key, val.Format(time.RFC3339Nano))
+}
+
+func (enc *jsonEncoder) AddUint(key string, val uint) { enc.AddUint64(key, uint64(val)) }
+func (enc *jsonEncoder) AddUint64(key string, val uint64) {
+	enc.addKey(key)
+	enc.buf.WriteString(strconv.FormatUint(val, 10))
+}
+func (enc *jsonEncoder) AddUint32(key string, val uint32)   { enc.AddUint64(key, uint64(val)) }
+func (enc *jsonEncoder) AddUint16(key string, val uint16)   { enc.AddUint64(key, uint64(val)) }
+func (enc *jsonEncoder) AddUint8(key string, val uint8)     { enc.AddUint64(key, uint64(val)) }
+func (enc *jsonEncoder) AddUintptr(key string, val uintptr) { enc.AddUint64(key, uint64(val)) }
+
+func (enc *jsonEncoder) AddReflected(key string, val interface{}) error {
+	if val == nil {
+		// Fast path: skip json.Marshal/reflection entirely for the common
+		// nil-pointer-field case.
+		enc.addNull(key)
+		return nil
+	}
+	b, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	enc.addKey(key)
+	enc.buf.Write(b)
+	return nil
+}
+
+func (enc *jsonEncoder) AddArray(key string, arr ArrayMarshaler) error {
+	inner := newJSONArrayEncoder()
+	if err := arr.MarshalLogArray(inner); err != nil {
+		return err
+	}
+	enc.addKey(key)
+	enc.buf.WriteByte('[')
+	enc.buf.Write(inner.buf.Bytes())
+	enc.buf.WriteByte(']')
+	return nil
+}
+
+func (enc *jsonEncoder) AddObject(key string, obj ObjectMarshaler) error {
+	inner := NewJSONEncoder()
+	if err := obj.MarshalLogObject(inner); err != nil {
+		return err
+	}
+	enc.addKey(key)
+	enc.buf.Write(inner.Buffer().Bytes())
+	return nil
+}
+
+// OpenNamespace opens an inline nested object that subsequent Add* calls
+// write into. Its matching '}' is emitted by Buffer, once all fields
+// (namespaced or not) have been added.
+func (enc *jsonEncoder) OpenNamespace(key string) {
+	enc.addKey(key)
+	enc.buf.WriteByte('{')
+	enc.firstField = true
+	enc.openNamespaces++
+}
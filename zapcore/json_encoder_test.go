@@ -0,0 +1,81 @@
+// Copyright (c) 2016 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package zapcore
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONEncoderAddReflectedNil(t *testing.T) {
+	enc := NewJSONEncoder()
+	if err := enc.AddReflected("val", nil); err != nil {
+		t.Fatalf("AddReflected(nil): %v", err)
+	}
+	enc.AddString("after", "ok")
+
+	got := enc.Buffer().String()
+	var decoded map[string]interface{}
+	if err := json.Unmarshal([]byte(got), &decoded); err != nil {
+		t.Fatalf("output %q is not valid JSON: %v", got, err)
+	}
+	if decoded["val"] != nil {
+		t.Errorf("val = %v, want nil", decoded["val"])
+	}
+	if decoded["after"] != "ok" {
+		t.Errorf("after = %v, want %q", decoded["after"], "ok")
+	}
+}
+
+func TestJSONEncoderNamespaceBalancesBraces(t *testing.T) {
+	enc := NewJSONEncoder()
+	enc.OpenNamespace("ns")
+	enc.AddString("a", "b")
+
+	got := enc.Buffer().String()
+	const want = `{"ns":{"a":"b"}}`
+	if got != want {
+		t.Fatalf("Buffer() = %q, want %q", got, want)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal([]byte(got), &decoded); err != nil {
+		t.Fatalf("output %q is not valid JSON: %v", got, err)
+	}
+}
+
+func TestJSONEncoderMultipleNamespaces(t *testing.T) {
+	enc := NewJSONEncoder()
+	enc.AddString("top", "v")
+	enc.OpenNamespace("ns1")
+	enc.AddInt("x", 1)
+	enc.OpenNamespace("ns2")
+	enc.AddInt("y", 2)
+
+	got := enc.Buffer().String()
+	var decoded map[string]interface{}
+	if err := json.Unmarshal([]byte(got), &decoded); err != nil {
+		t.Fatalf("output %q is not valid JSON: %v", got, err)
+	}
+	if decoded["top"] != "v" {
+		t.Errorf("top = %v, want %q", decoded["top"], "v")
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2016 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package zapcore
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// samplerBuckets is the size of the sampler's hash table. It's a fixed
+// power of two so entries with different (level, message) pairs rarely
+// collide; when they do, they simply share a counter (and thus a sampling
+// decision) for the rest of that tick.
+const samplerBuckets = 4096
+
+// samplerBucket counts occurrences of every (level, message) pair that
+// hashes into this slot during the current tick window.
+type samplerBucket struct {
+	resetAt int64 // UnixNano of the start of the current window
+	count   uint64
+}
+
+type samplerCore struct {
+	Core
+
+	tick       time.Duration
+	first      uint64
+	thereafter uint64
+	buckets    [samplerBuckets]samplerBucket
+}
+
+// NewSamplerWithOptions wraps core in a Core that limits the volume of
+// repetitive log entries. Within each tick window, it logs the first
+// occurrences of a given (level, message) pair unconditionally, then every
+// thereafter-th occurrence after that. All other entries are dropped.
+//
+// Counting is allocation-free: buckets are a fixed-size array indexed by a
+// hash of the level and message, updated with atomic operations. Different
+// messages that hash to the same bucket share a counter for that tick,
+// trading precision for a lock-free, allocation-free hot path.
+func NewSamplerWithOptions(core Core, tick time.Duration, first, thereafter int) Core {
+	return &samplerCore{
+		Core:       core,
+		tick:       tick,
+		first:      uint64(first),
+		thereafter: uint64(thereafter),
+	}
+}
+
+func (s *samplerCore) With(fields []Field) Core {
+	return &samplerCore{
+		Core:       s.Core.With(fields),
+		tick:       s.tick,
+		first:      s.first,
+		thereafter: s.thereafter,
+	}
+}
+
+func (s *samplerCore) Check(ent Entry, ce *CheckedEntry) *CheckedEntry {
+	if !s.Core.Enabled(ent.Level) {
+		return ce
+	}
+	if s.sample(ent) {
+		return s.Core.Check(ent, ce)
+	}
+	return ce
+}
+
+func (s *samplerCore) sample(ent Entry) bool {
+	bucket := &s.buckets[samplerHash(ent.Level, ent.Message)%samplerBuckets]
+
+	now := ent.Time.UnixNano()
+	resetAt := atomic.LoadInt64(&bucket.resetAt)
+	if now-resetAt >= int64(s.tick) {
+		// Only the goroutine that wins the CAS resets the counter; losers
+		// racing the boundary may still observe a pre-reset count for one
+		// increment, under- or over-sampling slightly right at the edge of
+		// a tick. That's an acceptable trade-off for an allocation-free,
+		// lock-free hot path.
+		if atomic.CompareAndSwapInt64(&bucket.resetAt, resetAt, now) {
+			atomic.StoreUint64(&bucket.count, 0)
+		}
+	}
+
+	n := atomic.AddUint64(&bucket.count, 1)
+	if n <= s.first {
+		return true
+	}
+	if s.thereafter == 0 {
+		return false
+	}
+	return (n-s.first)%s.thereafter == 0
+}
+
+// samplerHash is a tiny FNV-1a variant over the message, folded with the
+// level so otherwise-identical messages at different levels land in
+// different buckets.
+func samplerHash(level Level, msg string) uint64 {
+	const offset64 = 14695981039346656037
+	const prime64 = 1099511628211
+
+	h := uint64(offset64) ^ uint64(byte(level))
+	h *= prime64
+	for i := 0; i < len(msg); i++ {
+		h ^= uint64(msg[i])
+		h *= prime64
+	}
+	return h
+}
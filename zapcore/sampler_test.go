@@ -0,0 +1,106 @@
+// Copyright (c) 2016 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package zapcore
+
+import (
+	"testing"
+	"time"
+)
+
+// countingCore records every Entry it's asked to Check and always agrees to
+// log it; Enabled reports whether a level is at or above minLevel. It lets
+// tests observe
+// exactly which entries a Core wrapping it (e.g. a sampler) let through.
+type countingCore struct {
+	minLevel Level
+	checked  []Entry
+}
+
+func (c *countingCore) Enabled(lvl Level) bool { return lvl >= c.minLevel }
+func (c *countingCore) With([]Field) Core      { return c }
+func (c *countingCore) Check(ent Entry, ce *CheckedEntry) *CheckedEntry {
+	c.checked = append(c.checked, ent)
+	return ce.AddCore(ent, c)
+}
+func (c *countingCore) Write(Entry, []Field) error { return nil }
+func (c *countingCore) Sync() error                { return nil }
+
+func sampleN(s *samplerCore, at time.Time, n int) (logged int) {
+	for i := 0; i < n; i++ {
+		if s.sample(Entry{Level: InfoLevel, Message: "boom", Time: at}) {
+			logged++
+		}
+	}
+	return logged
+}
+
+func TestSamplerFirstAndThereafter(t *testing.T) {
+	inner := &countingCore{}
+	s := NewSamplerWithOptions(inner, time.Minute, 3, 5).(*samplerCore)
+
+	now := time.Unix(0, 0)
+	// First 3 occurrences always log; after that, every 5th does: 4-7
+	// dropped, 8 logged, 9-12 dropped, 13 logged. 15 calls -> 3 + 2 = 5.
+	if got, want := sampleN(s, now, 15), 5; got != want {
+		t.Errorf("logged = %d, want %d", got, want)
+	}
+}
+
+func TestSamplerZeroThereafterDropsAfterFirst(t *testing.T) {
+	inner := &countingCore{}
+	s := NewSamplerWithOptions(inner, time.Minute, 2, 0).(*samplerCore)
+
+	now := time.Unix(0, 0)
+	if got, want := sampleN(s, now, 10), 2; got != want {
+		t.Errorf("logged = %d, want %d", got, want)
+	}
+}
+
+func TestSamplerResetsOnNewTick(t *testing.T) {
+	inner := &countingCore{}
+	s := NewSamplerWithOptions(inner, time.Second, 1, 0).(*samplerCore)
+
+	t0 := time.Unix(0, 0)
+	if !s.sample(Entry{Level: InfoLevel, Message: "boom", Time: t0}) {
+		t.Fatalf("first occurrence in tick 1 should log")
+	}
+	if s.sample(Entry{Level: InfoLevel, Message: "boom", Time: t0}) {
+		t.Fatalf("second occurrence in the same tick should be dropped")
+	}
+
+	t1 := t0.Add(time.Second)
+	if !s.sample(Entry{Level: InfoLevel, Message: "boom", Time: t1}) {
+		t.Fatalf("first occurrence in the next tick should log again")
+	}
+}
+
+func TestSamplerCheckRespectsEnabled(t *testing.T) {
+	inner := &countingCore{}
+	s := NewSamplerWithOptions(inner, time.Minute, 1, 0)
+
+	ent := Entry{Level: DebugLevel, Message: "boom", Time: time.Unix(0, 0)}
+	if ce := s.Check(ent, nil); ce != nil {
+		t.Fatalf("Check() with a level the wrapped Core disables should return nil, got %v", ce)
+	}
+	if len(inner.checked) != 0 {
+		t.Fatalf("wrapped Core should never see a disabled-level entry")
+	}
+}
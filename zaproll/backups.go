@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2019. Temple3x (temple3x@gmail.com)
+ * Copyright (c) 2014 Nate Finch
+ *
+ * Use of this source code is governed by the MIT License
+ * that can be found in the LICENSE file.
+ */
+
+package zaproll
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// backupInfo describes one rotated log file on disk.
+type backupInfo struct {
+	name      string
+	timestamp time.Time
+}
+
+// oldLogFiles returns the backups of OutputPath present in its directory,
+// newest first. It recognizes both compressed (.gz) and uncompressed
+// backup filenames.
+func (l *Logger) oldLogFiles() ([]backupInfo, error) {
+	dir := filepath.Dir(l.cfg.OutputPath)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	base := filepath.Base(l.cfg.OutputPath)
+	ext := filepath.Ext(base)
+	prefix := base[:len(base)-len(ext)] + "-"
+
+	var backups []backupInfo
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		ts, ok := l.parseBackupTimestamp(name, prefix, ext)
+		if !ok {
+			continue
+		}
+		backups = append(backups, backupInfo{name: filepath.Join(dir, name), timestamp: ts})
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].timestamp.After(backups[j].timestamp)
+	})
+	return backups, nil
+}
+
+// parseBackupTimestamp extracts the timestamp embedded in a backup
+// filename, accepting an optional trailing ".gz" on top of the regular
+// extension.
+func (l *Logger) parseBackupTimestamp(name, prefix, ext string) (time.Time, bool) {
+	if !strings.HasPrefix(name, prefix) {
+		return time.Time{}, false
+	}
+	rest := name[len(prefix):]
+
+	rest = strings.TrimSuffix(rest, ".gz")
+	if !strings.HasSuffix(rest, ext) {
+		return time.Time{}, false
+	}
+	rest = strings.TrimSuffix(rest, ext)
+
+	loc := time.UTC
+	if l.cfg.LocalTime {
+		loc = time.Local
+	}
+	t, err := time.ParseInLocation(backupTimeFormat, rest, loc)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// cleanup enforces MaxBackups and MaxAge, deleting whichever backups fall
+// outside either bound.
+func (l *Logger) cleanup() error {
+	if l.cfg.MaxBackups <= 0 && l.cfg.MaxAge <= 0 {
+		return nil
+	}
+
+	backups, err := l.oldLogFiles()
+	if err != nil {
+		return err
+	}
+
+	var remove []backupInfo
+	if l.cfg.MaxBackups > 0 && len(backups) > l.cfg.MaxBackups {
+		remove = append(remove, backups[l.cfg.MaxBackups:]...)
+		backups = backups[:l.cfg.MaxBackups]
+	}
+	if l.cfg.MaxAge > 0 {
+		cutoff := currentTime().Add(-l.cfg.MaxAge)
+		for _, b := range backups {
+			if b.timestamp.Before(cutoff) {
+				remove = append(remove, b)
+			}
+		}
+	}
+
+	for _, b := range remove {
+		if err := os.Remove(b.name); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2019. Temple3x (temple3x@gmail.com)
+ * Copyright (c) 2014 Nate Finch
+ *
+ * Use of this source code is governed by the MIT License
+ * that can be found in the LICENSE file.
+ */
+
+package zaproll
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCleanupMaxAgeRemovesOldBackup(t *testing.T) {
+	dir := t.TempDir()
+	l := &Logger{cfg: Config{
+		OutputPath: filepath.Join(dir, "app.log"),
+		MaxAge:     time.Hour,
+	}}
+
+	old := l.backupName(currentTime().Add(-24 * time.Hour))
+	fresh := l.backupName(currentTime())
+	for _, name := range []string{old, fresh} {
+		if err := os.WriteFile(name, []byte("x"), 0644); err != nil {
+			t.Fatalf("seed backup %s: %v", name, err)
+		}
+	}
+
+	if err := l.cleanup(); err != nil {
+		t.Fatalf("cleanup() error = %v", err)
+	}
+
+	if _, err := os.Stat(old); !os.IsNotExist(err) {
+		t.Errorf("backup older than MaxAge still exists: stat err = %v", err)
+	}
+	if _, err := os.Stat(fresh); err != nil {
+		t.Errorf("backup within MaxAge was removed: %v", err)
+	}
+}
+
+func TestCleanupMaxBackupsKeepsNewest(t *testing.T) {
+	dir := t.TempDir()
+	l := &Logger{cfg: Config{
+		OutputPath: filepath.Join(dir, "app.log"),
+		MaxBackups: 1,
+	}}
+
+	older := l.backupName(currentTime().Add(-time.Hour))
+	newer := l.backupName(currentTime())
+	for _, name := range []string{older, newer} {
+		if err := os.WriteFile(name, []byte("x"), 0644); err != nil {
+			t.Fatalf("seed backup %s: %v", name, err)
+		}
+	}
+
+	if err := l.cleanup(); err != nil {
+		t.Fatalf("cleanup() error = %v", err)
+	}
+
+	if _, err := os.Stat(older); !os.IsNotExist(err) {
+		t.Errorf("backup beyond MaxBackups still exists: stat err = %v", err)
+	}
+	if _, err := os.Stat(newer); err != nil {
+		t.Errorf("newest backup within MaxBackups was removed: %v", err)
+	}
+}
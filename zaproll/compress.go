@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2019. Temple3x (temple3x@gmail.com)
+ * Copyright (c) 2014 Nate Finch
+ *
+ * Use of this source code is governed by the MIT License
+ * that can be found in the LICENSE file.
+ */
+
+package zaproll
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+)
+
+// compressWorkers bounds how many backups can be gzip-compressed
+// concurrently, so a burst of rotations can't spawn unbounded goroutines.
+const compressWorkers = 2
+
+func (l *Logger) startCompressWorkers() {
+	if !l.cfg.Compress {
+		return
+	}
+	ch := make(chan string, compressWorkers*4)
+	l.compressCh = ch
+	for i := 0; i < compressWorkers; i++ {
+		l.wg.Add(1)
+		go l.compressLoop(ch)
+	}
+}
+
+// compressLoop drains ch until it's closed. ch is passed in rather than
+// read from l.compressCh each time so a worker keeps draining the channel
+// it was started with even after Close nils out l.compressCh.
+func (l *Logger) compressLoop(ch chan string) {
+	defer l.wg.Done()
+	for path := range ch {
+		_ = compressFile(path)
+	}
+}
+
+// enqueueCompress schedules path for background gzip compression. It never
+// blocks the writer's hot path and never spawns unbounded goroutines: if
+// the bounded worker pool's queue is full, the backup is left uncompressed
+// rather than piling up ad-hoc compression goroutines.
+func (l *Logger) enqueueCompress(path string) {
+	if !l.cfg.Compress {
+		return
+	}
+	select {
+	case l.compressCh <- path:
+	default:
+		fmt.Fprintf(os.Stderr, "zaproll: compression queue full, leaving %s uncompressed\n", path)
+	}
+}
+
+// compressFile gzips src to src+".gz" via a temp file + rename, so a crash
+// mid-compression never leaves a half-written archive, then removes src.
+func compressFile(src string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	tmp := src + ".gz.tmp"
+	out, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		out.Close()
+		if err != nil {
+			os.Remove(tmp)
+		}
+	}()
+
+	gz := gzip.NewWriter(out)
+	if _, err = io.Copy(gz, in); err != nil {
+		return fmt.Errorf("zaproll: compress %s: %w", src, err)
+	}
+	if err = gz.Close(); err != nil {
+		return fmt.Errorf("zaproll: compress %s: %w", src, err)
+	}
+	if err = out.Sync(); err != nil {
+		return err
+	}
+	if err = out.Close(); err != nil {
+		return err
+	}
+
+	if err = os.Rename(tmp, src+".gz"); err != nil {
+		return err
+	}
+	return os.Remove(src)
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2019. Temple3x (temple3x@gmail.com)
+ * Copyright (c) 2014 Nate Finch
+ *
+ * Use of this source code is governed by the MIT License
+ * that can be found in the LICENSE file.
+ */
+
+package zaproll
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCompressFileProducesReadableGzipAndRemovesSource(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "app-backup.log")
+	const content = "some log lines\nmore log lines\n"
+	if err := os.WriteFile(src, []byte(content), 0644); err != nil {
+		t.Fatalf("seed source file: %v", err)
+	}
+
+	if err := compressFile(src); err != nil {
+		t.Fatalf("compressFile() error = %v", err)
+	}
+
+	if _, err := os.Stat(src); !os.IsNotExist(err) {
+		t.Errorf("source file still exists after compression: stat err = %v", err)
+	}
+
+	f, err := os.Open(src + ".gz")
+	if err != nil {
+		t.Fatalf("open compressed file: %v", err)
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatalf("gzip.NewReader: %v", err)
+	}
+	defer gz.Close()
+
+	got, err := io.ReadAll(gz)
+	if err != nil {
+		t.Fatalf("read gzip content: %v", err)
+	}
+	if string(got) != content {
+		t.Errorf("decompressed content = %q, want %q", got, content)
+	}
+}
+
+func TestEnqueueCompressCompressesInBackground(t *testing.T) {
+	dir := t.TempDir()
+	l, err := New(Config{
+		OutputPath: filepath.Join(dir, "app.log"),
+		Compress:   true,
+		Developed:  true,
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer l.Close()
+
+	src := filepath.Join(dir, "app-backup.log")
+	if err := os.WriteFile(src, []byte("payload"), 0644); err != nil {
+		t.Fatalf("seed backup file: %v", err)
+	}
+
+	l.enqueueCompress(src)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if _, err := os.Stat(src + ".gz"); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s to appear", src+".gz")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if _, err := os.Stat(src); !os.IsNotExist(err) {
+		t.Errorf("source file still exists after background compression: stat err = %v", err)
+	}
+}
@@ -8,6 +8,8 @@
 
 package zaproll
 
+import "time"
+
 // Config of zaproll.
 type Config struct {
 	// OutputPath is the log file path.
@@ -22,6 +24,29 @@ type Config struct {
 	// If true, use local time.
 	LocalTime bool `json:"local_time" toml:"local_time"`
 
+	// RotateInterval rotates the current file on a fixed schedule (e.g.
+	// every 24h), independent of MaxSize. Zero disables interval-based
+	// rotation. RotateInterval and RotateAt are mutually exclusive; if both
+	// are set, RotateAt takes precedence.
+	RotateInterval time.Duration `json:"rotate_interval" toml:"rotate_interval"`
+	// RotateAt rotates the current file once a day at the given time of day,
+	// formatted as "15:04" (e.g. "00:00"). The time is read in UTC, or in
+	// local time when LocalTime is true. Empty disables time-of-day
+	// rotation.
+	RotateAt string `json:"rotate_at" toml:"rotate_at"`
+	// MaxAge is the maximum duration to retain a backup log file, based on
+	// the timestamp encoded in its filename. Backups older than MaxAge are
+	// deleted during the same sweep that enforces MaxBackups. Zero disables
+	// age-based retention.
+	MaxAge time.Duration `json:"max_age" toml:"max_age"`
+	// Compress enables gzip compression of rotated backup log files.
+	// Compression happens asynchronously on a bounded worker pool so it
+	// never blocks the writer's hot path. Because of that, MaxBackups and
+	// MaxAge enforcement is eventual, not immediate: a backup compressed
+	// after the cleanup sweep that was supposed to retire it reappears as
+	// a ".gz" file and is only removed by the next rotation's sweep.
+	Compress bool `json:"compress" toml:"compress"`
+
 	// PerWriteSize is zaproll's write size,
 	// zaproll writes data to page cache every PerWriteSize.
 	// Unit: KB.
@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2019. Temple3x (temple3x@gmail.com)
+ * Copyright (c) 2014 Nate Finch
+ *
+ * Use of this source code is governed by the MIT License
+ * that can be found in the LICENSE file.
+ */
+
+package zaproll
+
+import (
+	"fmt"
+	"time"
+)
+
+const rotateAtFormat = "15:04"
+
+// nextRotation returns the next time the background goroutine should force
+// a rotation, based on RotateAt (if set) or RotateInterval. It returns the
+// zero Time when neither is configured, meaning scheduled rotation is off.
+func (l *Logger) nextRotation(from time.Time) (time.Time, error) {
+	if l.cfg.RotateAt != "" {
+		at, err := time.Parse(rotateAtFormat, l.cfg.RotateAt)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("zaproll: invalid rotate_at %q: %w", l.cfg.RotateAt, err)
+		}
+
+		loc := time.UTC
+		if l.cfg.LocalTime {
+			loc = time.Local
+		}
+		next := time.Date(from.Year(), from.Month(), from.Day(), at.Hour(), at.Minute(), 0, 0, loc)
+		if !next.After(from) {
+			next = next.AddDate(0, 0, 1)
+		}
+		return next, nil
+	}
+
+	if l.cfg.RotateInterval > 0 {
+		return from.Add(l.cfg.RotateInterval), nil
+	}
+
+	return time.Time{}, nil
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2019. Temple3x (temple3x@gmail.com)
+ * Copyright (c) 2014 Nate Finch
+ *
+ * Use of this source code is governed by the MIT License
+ * that can be found in the LICENSE file.
+ */
+
+package zaproll
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextRotationDisabled(t *testing.T) {
+	l := &Logger{}
+	next, err := l.nextRotation(time.Now())
+	if err != nil {
+		t.Fatalf("nextRotation() error = %v", err)
+	}
+	if !next.IsZero() {
+		t.Errorf("next = %v, want zero Time when neither RotateAt nor RotateInterval is set", next)
+	}
+}
+
+func TestNextRotationInterval(t *testing.T) {
+	l := &Logger{cfg: Config{RotateInterval: time.Hour}}
+	from := time.Date(2024, time.January, 2, 10, 0, 0, 0, time.UTC)
+
+	next, err := l.nextRotation(from)
+	if err != nil {
+		t.Fatalf("nextRotation() error = %v", err)
+	}
+	if want := from.Add(time.Hour); !next.Equal(want) {
+		t.Errorf("next = %v, want %v", next, want)
+	}
+}
+
+func TestNextRotationAtLaterToday(t *testing.T) {
+	l := &Logger{cfg: Config{RotateAt: "23:00"}}
+	from := time.Date(2024, time.January, 2, 10, 0, 0, 0, time.UTC)
+
+	next, err := l.nextRotation(from)
+	if err != nil {
+		t.Fatalf("nextRotation() error = %v", err)
+	}
+	want := time.Date(2024, time.January, 2, 23, 0, 0, 0, time.UTC)
+	if !next.Equal(want) {
+		t.Errorf("next = %v, want %v", next, want)
+	}
+}
+
+func TestNextRotationAtAlreadyPassedToday(t *testing.T) {
+	l := &Logger{cfg: Config{RotateAt: "00:00"}}
+	from := time.Date(2024, time.January, 2, 10, 0, 0, 0, time.UTC)
+
+	next, err := l.nextRotation(from)
+	if err != nil {
+		t.Fatalf("nextRotation() error = %v", err)
+	}
+	want := time.Date(2024, time.January, 3, 0, 0, 0, 0, time.UTC)
+	if !next.Equal(want) {
+		t.Errorf("next = %v, want %v", next, want)
+	}
+}
+
+func TestNextRotationAtInvalidFormat(t *testing.T) {
+	l := &Logger{cfg: Config{RotateAt: "nonsense"}}
+	if _, err := l.nextRotation(time.Now()); err == nil {
+		t.Fatalf("nextRotation() error = nil, want an error for an invalid RotateAt")
+	}
+}
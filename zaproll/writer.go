@@ -0,0 +1,238 @@
+/*
+ * Copyright (c) 2019. Temple3x (temple3x@gmail.com)
+ * Copyright (c) 2014 Nate Finch
+ *
+ * Use of this source code is governed by the MIT License
+ * that can be found in the LICENSE file.
+ */
+
+package zaproll
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Logger is an io.WriteCloser that writes to OutputPath, rotating the
+// underlying file according to Config.
+type Logger struct {
+	cfg Config
+
+	mu   sync.Mutex
+	file *os.File
+	// size is the size of the current file.
+	size int64
+	// unsynced is the number of bytes written since the last Sync call.
+	unsynced int64
+
+	// rotateAt is the next time a scheduled rotation (RotateInterval /
+	// RotateAt) is due. Zero means scheduled rotation is disabled.
+	rotateAt time.Time
+
+	// compressCh feeds the bounded compression worker pool. Nil unless
+	// Compress is enabled.
+	compressCh chan string
+
+	// syncTick drives the background goroutine that periodically flushes
+	// data to storage media and checks for scheduled rotation.
+	syncTick *time.Ticker
+	done     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// New creates a Logger ready to write to cfg.OutputPath.
+func New(cfg Config) (*Logger, error) {
+	cfg.adjust()
+
+	l := &Logger{
+		cfg:  cfg,
+		done: make(chan struct{}),
+	}
+	if err := l.openExistingOrNew(); err != nil {
+		return nil, err
+	}
+
+	rotateAt, err := l.nextRotation(currentTime())
+	if err != nil {
+		return nil, err
+	}
+	l.rotateAt = rotateAt
+
+	l.startCompressWorkers()
+
+	l.syncTick = time.NewTicker(time.Second)
+	l.wg.Add(1)
+	go l.run()
+
+	return l, nil
+}
+
+// Write implements io.Writer. It rotates the file if writing p would put it
+// over MaxSize.
+func (l *Logger) Write(p []byte) (n int, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.size+int64(len(p)) > l.cfg.MaxSize {
+		if err := l.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err = l.file.Write(p)
+	l.size += int64(n)
+	l.unsynced += int64(n)
+	if err != nil {
+		return n, err
+	}
+
+	if l.unsynced >= l.cfg.PerSyncSize {
+		err = l.file.Sync()
+		l.unsynced = 0
+	}
+	return n, err
+}
+
+// Close implements io.Closer. It stops the background goroutine and closes
+// the current file.
+//
+// Closing compressCh is guarded by l.mu because enqueueCompress is only
+// ever called while l.mu is held (via Write/run -> rotate -> openNew); take
+// the lock here too, so Close can never race a concurrent send on the same
+// channel.
+func (l *Logger) Close() error {
+	l.mu.Lock()
+	close(l.done)
+	if l.compressCh != nil {
+		close(l.compressCh)
+		l.compressCh = nil
+	}
+	l.mu.Unlock()
+
+	l.wg.Wait()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.close()
+}
+
+func (l *Logger) close() error {
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+// Rotate closes the current file, renames it to a timestamped backup, and
+// opens a new file for OutputPath.
+func (l *Logger) Rotate() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.rotate()
+}
+
+func (l *Logger) rotate() error {
+	if err := l.close(); err != nil {
+		return err
+	}
+	if err := l.openNew(); err != nil {
+		return err
+	}
+	// cleanup can run while backups are still being compressed
+	// asynchronously (see Config.Compress): a backup this sweep removes
+	// under its uncompressed name while a worker is compressing it still
+	// ends up as a ".gz" file, which only the next rotation's sweep removes.
+	return l.cleanup()
+}
+
+func (l *Logger) openExistingOrNew() error {
+	info, err := os.Stat(l.cfg.OutputPath)
+	if os.IsNotExist(err) {
+		return l.openNew()
+	}
+	if err != nil {
+		return fmt.Errorf("zaproll: stat output path: %w", err)
+	}
+
+	file, err := os.OpenFile(l.cfg.OutputPath, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return l.openNew()
+	}
+	l.file = file
+	l.size = info.Size()
+	return nil
+}
+
+func (l *Logger) openNew() error {
+	if err := os.MkdirAll(filepath.Dir(l.cfg.OutputPath), 0755); err != nil {
+		return fmt.Errorf("zaproll: make output directory: %w", err)
+	}
+
+	if _, err := os.Stat(l.cfg.OutputPath); err == nil {
+		backup := l.backupName(currentTime())
+		if err := os.Rename(l.cfg.OutputPath, backup); err != nil {
+			return fmt.Errorf("zaproll: rename current log file: %w", err)
+		}
+		l.enqueueCompress(backup)
+	}
+
+	file, err := os.OpenFile(l.cfg.OutputPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("zaproll: open new log file: %w", err)
+	}
+	l.file = file
+	l.size = 0
+	return nil
+}
+
+func currentTime() time.Time {
+	return time.Now()
+}
+
+// backupName returns the backup filename for t, e.g. "foo-2024-01-02T15-04-05.000.log".
+func (l *Logger) backupName(t time.Time) string {
+	dir := filepath.Dir(l.cfg.OutputPath)
+	base := filepath.Base(l.cfg.OutputPath)
+	ext := filepath.Ext(base)
+	prefix := base[:len(base)-len(ext)]
+
+	if !l.cfg.LocalTime {
+		t = t.UTC()
+	}
+	timestamp := t.Format(backupTimeFormat)
+	return filepath.Join(dir, fmt.Sprintf("%s-%s%s", prefix, timestamp, ext))
+}
+
+const backupTimeFormat = "2006-01-02T15-04-05.000"
+
+func (l *Logger) run() {
+	defer l.wg.Done()
+	defer l.syncTick.Stop()
+
+	for {
+		select {
+		case <-l.syncTick.C:
+			l.mu.Lock()
+			if l.file != nil && l.unsynced > 0 {
+				l.file.Sync()
+				l.unsynced = 0
+			}
+
+			now := currentTime()
+			if !l.rotateAt.IsZero() && !now.Before(l.rotateAt) {
+				l.rotate()
+				if next, err := l.nextRotation(now); err == nil {
+					l.rotateAt = next
+				}
+			}
+			l.mu.Unlock()
+		case <-l.done:
+			return
+		}
+	}
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2019. Temple3x (temple3x@gmail.com)
+ * Copyright (c) 2014 Nate Finch
+ *
+ * Use of this source code is governed by the MIT License
+ * that can be found in the LICENSE file.
+ */
+
+package zaproll
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+// TestWriteCloseConcurrent exercises Write, rotation (via compression), and
+// Close racing each other. It should pass under -race: Close must be able
+// to run concurrently with in-flight Writes without a send on a closed
+// compressCh panicking the process.
+func TestWriteCloseConcurrent(t *testing.T) {
+	dir := t.TempDir()
+	l, err := New(Config{
+		OutputPath: filepath.Join(dir, "app.log"),
+		MaxSize:    1, // 1 byte under Developed; forces a rotation on every write.
+		Compress:   true,
+		Developed:  true,
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				if _, err := l.Write([]byte("hello world, this is a log line\n")); err != nil {
+					// Close raced ahead of us; the file is gone, which is
+					// expected, not a bug.
+					return
+				}
+			}
+		}()
+	}
+
+	// Race Close against the in-flight Writes above instead of waiting for
+	// them to finish first.
+	if err := l.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	wg.Wait()
+}